@@ -0,0 +1,42 @@
+/*******************************************************************************
+The MIT License (MIT)
+
+Copyright (c) 2013-2020 Hajime Nakagami
+
+Permission is hereby granted, free of charge, to any person obtaining a copy of
+this software and associated documentation files (the "Software"), to deal in
+the Software without restriction, including without limitation the rights to
+use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+the Software, and to permit persons to whom the Software is furnished to do so,
+subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*******************************************************************************/
+
+package firebirdsql
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// ErrBlobStreamingUnsupported is the error BlobReader always returns.
+// xSQLVAR.value returns BLOB columns as a fully-buffered []byte, or as a
+// string for SUB_TYPE 1 (TEXT).
+var ErrBlobStreamingUnsupported = errors.New("firebirdsql: streaming BlobReader is not implemented (requires op_get_segment/op_close_blob wiring)")
+
+// BlobReader does not open or read the BLOB identified by id: it always
+// returns a nil reader and ErrBlobStreamingUnsupported, and no segments
+// are fetched through it.
+func (x *xSQLVAR) BlobReader(ctx context.Context, conn *firebirdsqlConn, transHandle int32, id [8]byte) (io.ReadCloser, error) {
+	return nil, ErrBlobStreamingUnsupported
+}
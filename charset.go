@@ -0,0 +1,227 @@
+/*******************************************************************************
+The MIT License (MIT)
+
+Copyright (c) 2013-2020 Hajime Nakagami
+
+Permission is hereby granted, free of charge, to any person obtaining a copy of
+this software and associated documentation files (the "Software"), to deal in
+the Software without restriction, including without limitation the rights to
+use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+the Software, and to permit persons to whom the Software is furnished to do so,
+subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*******************************************************************************/
+
+package firebirdsql
+
+import (
+	"fmt"
+	"sync"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+	"golang.org/x/text/encoding/japanese"
+	"golang.org/x/text/encoding/korean"
+	"golang.org/x/text/encoding/simplifiedchinese"
+	"golang.org/x/text/encoding/traditionalchinese"
+	"golang.org/x/text/encoding/unicode"
+)
+
+// CharsetError is returned when a Firebird charset can not be decoded or
+// encoded, instead of the error being silently dropped.
+type CharsetError struct {
+	Charset string
+	Err     error
+}
+
+func (e *CharsetError) Error() string {
+	return fmt.Sprintf("firebirdsql: charset %s: %s", e.Charset, e.Err)
+}
+
+func (e *CharsetError) Unwrap() error {
+	return e.Err
+}
+
+type charsetDecoder func(raw_value []byte) (string, error)
+type charsetEncoder func(s string) ([]byte, error)
+
+var charsetRegistryMu sync.RWMutex
+var charsetDecoders = map[string]charsetDecoder{}
+var charsetEncoders = map[string]charsetEncoder{}
+
+// RegisterCharset registers the decoder used for raw_value bytes coming from a
+// column or parameter in the named Firebird charset. It may be called at
+// init time by user code to override or extend the built-in mappings below.
+func RegisterCharset(name string, dec charsetDecoder) {
+	charsetRegistryMu.Lock()
+	defer charsetRegistryMu.Unlock()
+	charsetDecoders[name] = dec
+}
+
+// RegisterCharsetEncoder registers the inverse of RegisterCharset, which
+// LookupCharsetEncoder returns. The driver does not call these encoders
+// itself: bound string parameters are not encoded through this registry.
+func RegisterCharsetEncoder(name string, enc charsetEncoder) {
+	charsetRegistryMu.Lock()
+	defer charsetRegistryMu.Unlock()
+	charsetEncoders[name] = enc
+}
+
+// LookupCharset returns the decoder registered for name, if any.
+func LookupCharset(name string) (charsetDecoder, bool) {
+	charsetRegistryMu.RLock()
+	defer charsetRegistryMu.RUnlock()
+	dec, ok := charsetDecoders[name]
+	return dec, ok
+}
+
+// LookupCharsetEncoder returns the encoder registered for name, if any.
+func LookupCharsetEncoder(name string) (charsetEncoder, bool) {
+	charsetRegistryMu.RLock()
+	defer charsetRegistryMu.RUnlock()
+	enc, ok := charsetEncoders[name]
+	return enc, ok
+}
+
+func registerEncoding(name string, enc encoding.Encoding) {
+	RegisterCharset(name, func(raw_value []byte) (string, error) {
+		v, err := enc.NewDecoder().Bytes(raw_value)
+		if err != nil {
+			return "", &CharsetError{Charset: name, Err: err}
+		}
+		return string(v), nil
+	})
+	RegisterCharsetEncoder(name, func(s string) ([]byte, error) {
+		v, err := enc.NewEncoder().Bytes([]byte(s))
+		if err != nil {
+			return nil, &CharsetError{Charset: name, Err: err}
+		}
+		return v, nil
+	})
+}
+
+func registerIdentity(name string) {
+	RegisterCharset(name, func(raw_value []byte) (string, error) {
+		return string(raw_value), nil
+	})
+	RegisterCharsetEncoder(name, func(s string) ([]byte, error) {
+		return []byte(s), nil
+	})
+}
+
+// registerDerivedCodepage registers a single-byte DOS code page that isn't
+// shipped by golang.org/x/text/encoding/charmap by patching the cells that
+// differ from a closely related code page that is.
+func registerDerivedCodepage(name string, base *charmap.Charmap, overrides map[byte]rune) {
+	dec := base.NewDecoder()
+	runeOf := func(b byte) rune {
+		if r, ok := overrides[b]; ok {
+			return r
+		}
+		v, err := dec.Bytes([]byte{b})
+		if err != nil || len(v) == 0 {
+			return rune(b)
+		}
+		return []rune(string(v))[0]
+	}
+	var table [256]rune
+	byteOf := map[rune]byte{}
+	for i := 0; i < 256; i++ {
+		r := runeOf(byte(i))
+		table[i] = r
+		byteOf[r] = byte(i)
+	}
+	RegisterCharset(name, func(raw_value []byte) (string, error) {
+		runes := make([]rune, len(raw_value))
+		for i, b := range raw_value {
+			runes[i] = table[b]
+		}
+		return string(runes), nil
+	})
+	RegisterCharsetEncoder(name, func(s string) ([]byte, error) {
+		out := make([]byte, 0, len(s))
+		for _, r := range s {
+			b, ok := byteOf[r]
+			if !ok {
+				return nil, &CharsetError{Charset: name, Err: fmt.Errorf("rune %q has no mapping", r)}
+			}
+			out = append(out, b)
+		}
+		return out, nil
+	})
+}
+
+func init() {
+	registerIdentity("NONE")
+	registerIdentity("ASCII")
+	registerIdentity("OCTETS")
+	registerIdentity("UNICODE_FSS")
+	registerIdentity("UTF8")
+
+	registerEncoding("SJIS_0208", japanese.ShiftJIS)
+	registerEncoding("EUCJ_0208", japanese.EUCJP)
+	registerEncoding("ISO8859_1", charmap.ISO8859_1)
+	registerEncoding("ISO8859_2", charmap.ISO8859_2)
+	registerEncoding("ISO8859_3", charmap.ISO8859_3)
+	registerEncoding("ISO8859_4", charmap.ISO8859_4)
+	registerEncoding("ISO8859_5", charmap.ISO8859_5)
+	registerEncoding("ISO8859_6", charmap.ISO8859_6)
+	registerEncoding("ISO8859_7", charmap.ISO8859_7)
+	registerEncoding("ISO8859_8", charmap.ISO8859_8)
+	registerEncoding("ISO8859_9", charmap.ISO8859_9)
+	registerEncoding("ISO8859_13", charmap.ISO8859_13)
+	registerEncoding("KSC_5601", korean.EUCKR)
+	registerEncoding("WIN1250", charmap.Windows1250)
+	registerEncoding("WIN1251", charmap.Windows1251)
+	registerEncoding("WIN1252", charmap.Windows1252)
+	registerEncoding("WIN1253", charmap.Windows1253)
+	registerEncoding("WIN1254", charmap.Windows1254)
+	registerEncoding("WIN1255", charmap.Windows1255)
+	registerEncoding("WIN1256", charmap.Windows1256)
+	registerEncoding("WIN1257", charmap.Windows1257)
+	registerEncoding("WIN1258", charmap.Windows1258)
+	registerEncoding("BIG_5", traditionalchinese.Big5)
+	registerEncoding("GB_2312", simplifiedchinese.GBK)
+	registerEncoding("KOI8R", charmap.KOI8R)
+	registerEncoding("KOI8U", charmap.KOI8U)
+	registerEncoding("CYRL", charmap.CodePage866)
+	registerEncoding("TIS620", charmap.Windows874)
+	registerEncoding("DOS437", charmap.CodePage437)
+	registerEncoding("DOS850", charmap.CodePage850)
+	registerEncoding("DOS852", charmap.CodePage852)
+	registerEncoding("DOS860", charmap.CodePage860)
+	registerEncoding("DOS863", charmap.CodePage863)
+	registerEncoding("DOS865", charmap.CodePage865)
+	registerEncoding("DOS866", charmap.CodePage866)
+	registerEncoding("UTF16", unicode.UTF16(unicode.BigEndian, unicode.IgnoreBOM))
+
+	// DOS857 (Turkish) isn't shipped by golang.org/x/text/encoding/charmap;
+	// derive it from the closely related CodePage850 table, which agrees
+	// with CP857 everywhere except these 14 cells. Three of those (0xD5,
+	// 0xE7, 0xF2) are holes left undefined by the official CP857 table
+	// rather than redefinitions, so they decode as the replacement
+	// character instead of silently falling back to CP850's glyph there.
+	registerDerivedCodepage("DOS857", charmap.CodePage850, map[byte]rune{
+		0x8D: 'ı', 0x98: 'İ', 0x9E: 'Ş', 0x9F: 'ş', 0xA6: 'Ğ', 0xA7: 'ğ',
+		0xD0: 'º', 0xD1: 'ª', 0xD5: '\uFFFD',
+		0xE7: '\uFFFD', 0xE8: '×', 0xEC: 'ì', 0xED: 'ÿ', 0xF2: '\uFFFD',
+	})
+	// DOS861 (Icelandic) isn't shipped either. CP850 is the wrong base for
+	// it: CP850 redefines the 0xB0-0xDF box-drawing range with extra Latin
+	// letters, while CP861 (like CP865, already registered above) keeps
+	// CP437's original box-drawing glyphs there and only swaps a handful
+	// of Latin-1 letter cells below 0xB0. Derive from CodePage865 instead.
+	registerDerivedCodepage("DOS861", charmap.CodePage865, map[byte]rune{
+		0x8B: 'Ð', 0x8C: 'ð', 0x8D: 'Þ', 0x95: 'þ', 0x97: 'Ý', 0x98: 'ý',
+		0xA4: 'Á', 0xA5: 'Í', 0xA6: 'Ó', 0xA7: 'Ú', 0xAF: '»',
+	})
+}
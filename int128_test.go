@@ -0,0 +1,84 @@
+/*******************************************************************************
+The MIT License (MIT)
+
+Copyright (c) 2013-2020 Hajime Nakagami
+
+Permission is hereby granted, free of charge, to any person obtaining a copy of
+this software and associated documentation files (the "Software"), to deal in
+the Software without restriction, including without limitation the rights to
+use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+the Software, and to permit persons to whom the Software is furnished to do so,
+subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*******************************************************************************/
+
+package firebirdsql
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/shopspring/decimal"
+)
+
+func TestBytesToInt128RoundTrip(t *testing.T) {
+	max127 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	min127 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+
+	for _, want := range []*big.Int{max127, min127, big.NewInt(0), big.NewInt(-1), big.NewInt(123456789)} {
+		raw := int128ToBytes(want)
+		got := bytesToInt128(raw[:])
+		if got.Cmp(want) != 0 {
+			t.Errorf("bytesToInt128(int128ToBytes(%s)) = %s, want %s", want, got, want)
+		}
+	}
+}
+
+// TestXSQLVAREncodeInt128RoundTrip exercises the parameter encoder added in
+// param.go against values near ±2^127 across the full scale range Firebird 4
+// permits for NUMERIC(38, s), -38..38.
+func TestXSQLVAREncodeInt128RoundTrip(t *testing.T) {
+	max127 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	min127 := new(big.Int).Neg(new(big.Int).Lsh(big.NewInt(1), 127))
+
+	for _, coeff := range []*big.Int{max127, min127, big.NewInt(0), big.NewInt(-123456789)} {
+		for scale := -38; scale <= 38; scale++ {
+			x := &xSQLVAR{sqltype: SQL_TYPE_INT128, sqlscale: scale}
+			d := decimal.NewFromBigInt(coeff, int32(scale))
+
+			raw, err := x.encode(d)
+			if err != nil {
+				t.Fatalf("coeff=%s scale=%d: encode: %s", coeff, scale, err)
+			}
+			gotCoeff := bytesToInt128(raw)
+			if gotCoeff.Cmp(coeff) != 0 {
+				t.Errorf("coeff=%s scale=%d: got coefficient %s, want %s", coeff, scale, gotCoeff, coeff)
+			}
+			if got := decimal.NewFromBigInt(gotCoeff, int32(scale)); !got.Equal(d) {
+				t.Errorf("coeff=%s scale=%d: got %s, want %s", coeff, scale, got, d)
+			}
+		}
+	}
+}
+
+func TestXSQLVAREncodeInt128NoScale(t *testing.T) {
+	max127 := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 127), big.NewInt(1))
+	x := &xSQLVAR{sqltype: SQL_TYPE_INT128, sqlscale: 0}
+
+	raw, err := x.encode(max127)
+	if err != nil {
+		t.Fatalf("encode: %s", err)
+	}
+	if got := bytesToInt128(raw); got.Cmp(max127) != 0 {
+		t.Errorf("got %s, want %s", got, max127)
+	}
+}
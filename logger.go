@@ -0,0 +1,80 @@
+/*******************************************************************************
+The MIT License (MIT)
+
+Copyright (c) 2013-2020 Hajime Nakagami
+
+Permission is hereby granted, free of charge, to any person obtaining a copy of
+this software and associated documentation files (the "Software"), to deal in
+the Software without restriction, including without limitation the rights to
+use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+the Software, and to permit persons to whom the Software is furnished to do so,
+subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*******************************************************************************/
+
+package firebirdsql
+
+import "sync"
+
+// Logger is the driver's diagnostics hook. The default is a no-op; call
+// SetLogger to route driver diagnostics (timezone decoding, LoadLocation
+// failures, charset decode failures, ...) into an application's own logging.
+type Logger interface {
+	Debugf(format string, args ...interface{})
+	Infof(format string, args ...interface{})
+	Errorf(format string, args ...interface{})
+}
+
+type nopLogger struct{}
+
+func (nopLogger) Debugf(format string, args ...interface{}) {}
+func (nopLogger) Infof(format string, args ...interface{})  {}
+func (nopLogger) Errorf(format string, args ...interface{}) {}
+
+var loggerMu sync.RWMutex
+var currentLogger Logger = nopLogger{}
+
+// logger proxies to the currently installed Logger, guarded by loggerMu so
+// SetLogger is safe to call concurrently with in-flight queries.
+var logger loggerProxy
+
+type loggerProxy struct{}
+
+func (loggerProxy) Debugf(format string, args ...interface{}) {
+	loggerMu.RLock()
+	defer loggerMu.RUnlock()
+	currentLogger.Debugf(format, args...)
+}
+
+func (loggerProxy) Infof(format string, args ...interface{}) {
+	loggerMu.RLock()
+	defer loggerMu.RUnlock()
+	currentLogger.Infof(format, args...)
+}
+
+func (loggerProxy) Errorf(format string, args ...interface{}) {
+	loggerMu.RLock()
+	defer loggerMu.RUnlock()
+	currentLogger.Errorf(format, args...)
+}
+
+// SetLogger installs l as the package-level Logger used by this driver.
+// Passing nil restores the no-op default. Safe to call concurrently with
+// in-flight queries.
+func SetLogger(l Logger) {
+	if l == nil {
+		l = nopLogger{}
+	}
+	loggerMu.Lock()
+	defer loggerMu.Unlock()
+	currentLogger = l
+}
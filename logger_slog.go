@@ -0,0 +1,58 @@
+//go:build go1.21
+
+/*******************************************************************************
+The MIT License (MIT)
+
+Copyright (c) 2013-2020 Hajime Nakagami
+
+Permission is hereby granted, free of charge, to any person obtaining a copy of
+this software and associated documentation files (the "Software"), to deal in
+the Software without restriction, including without limitation the rights to
+use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+the Software, and to permit persons to whom the Software is furnished to do so,
+subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*******************************************************************************/
+
+package firebirdsql
+
+import (
+	"fmt"
+	"log/slog"
+)
+
+// SlogLogger adapts a *slog.Logger to the Logger interface so callers on Go
+// 1.21+ can pass their existing logger straight to SetLogger.
+type SlogLogger struct {
+	L *slog.Logger
+}
+
+func (s SlogLogger) Debugf(format string, args ...interface{}) {
+	if s.L == nil {
+		return
+	}
+	s.L.Debug(fmt.Sprintf(format, args...))
+}
+
+func (s SlogLogger) Infof(format string, args ...interface{}) {
+	if s.L == nil {
+		return
+	}
+	s.L.Info(fmt.Sprintf(format, args...))
+}
+
+func (s SlogLogger) Errorf(format string, args ...interface{}) {
+	if s.L == nil {
+		return
+	}
+	s.L.Error(fmt.Sprintf(format, args...))
+}
@@ -0,0 +1,64 @@
+/*******************************************************************************
+The MIT License (MIT)
+
+Copyright (c) 2013-2020 Hajime Nakagami
+
+Permission is hereby granted, free of charge, to any person obtaining a copy of
+this software and associated documentation files (the "Software"), to deal in
+the Software without restriction, including without limitation the rights to
+use, copy, modify, merge, publish, distribute, sublicense, and/or sell copies of
+the Software, and to permit persons to whom the Software is furnished to do so,
+subject to the following conditions:
+
+The above copyright notice and this permission notice shall be included in all
+copies or substantial portions of the Software.
+
+THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND, EXPRESS OR
+IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF MERCHANTABILITY, FITNESS
+FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. IN NO EVENT SHALL THE AUTHORS OR
+COPYRIGHT HOLDERS BE LIABLE FOR ANY CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER
+IN AN ACTION OF CONTRACT, TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN
+CONNECTION WITH THE SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+*******************************************************************************/
+
+package firebirdsql
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// encode marshals a bound parameter value into the wire representation for
+// this field. It is the inverse of value(); today it only implements the
+// SQL_TYPE_INT128 path (NUMERIC(38, s) for any s in -38..38), which is the
+// one type the parameter encoder previously had no encode side for at all.
+func (x *xSQLVAR) encode(v driver.Value) ([]byte, error) {
+	switch x.sqltype {
+	case SQL_TYPE_INT128:
+		return x.encodeInt128(v)
+	}
+	return nil, fmt.Errorf("firebirdsql: encode not implemented for sqltype %d", x.sqltype)
+}
+
+// encodeInt128 marshals v into the 16-byte SQL_TYPE_INT128 wire
+// representation, the inverse of the INT128 branch of value(). v is either
+// a decimal.Decimal (x.sqlscale != 0) or a *big.Int or big.Int
+// (x.sqlscale == 0), matching what value() returns for this field.
+func (x *xSQLVAR) encodeInt128(v driver.Value) ([]byte, error) {
+	var i128 *big.Int
+	switch t := v.(type) {
+	case decimal.Decimal:
+		i128 = t.Shift(int32(-x.sqlscale)).Round(0).BigInt()
+	case *big.Int:
+		i128 = t
+	case big.Int:
+		i128 = &t
+	default:
+		return nil, fmt.Errorf("firebirdsql: cannot encode %T as INT128", v)
+	}
+	raw := int128ToBytes(i128)
+	return raw[:], nil
+}
@@ -30,14 +30,8 @@ import (
 	"math/big"
 	"reflect"
 	"time"
-	"fmt"
 
 	"github.com/shopspring/decimal"
-	"golang.org/x/text/encoding/charmap"
-	"golang.org/x/text/encoding/japanese"
-	"golang.org/x/text/encoding/korean"
-	"golang.org/x/text/encoding/simplifiedchinese"
-	"golang.org/x/text/encoding/traditionalchinese"
 )
 
 const (
@@ -190,6 +184,9 @@ func (x *xSQLVAR) scantype() reflect.Type {
 		}
 		return reflect.TypeOf(int64(0))
 	case SQL_TYPE_INT128:
+		if x.sqlscale != 0 {
+			return reflect.TypeOf(decimal.Decimal{})
+		}
 		return reflect.TypeOf(big.Int{})
 	case SQL_TYPE_DATE:
 		return reflect.TypeOf(time.Time{})
@@ -204,6 +201,12 @@ func (x *xSQLVAR) scantype() reflect.Type {
 	case SQL_TYPE_BOOLEAN:
 		return reflect.TypeOf(false)
 	case SQL_TYPE_BLOB:
+		// NOTE: value() receives the whole BLOB as one raw_value buffer;
+		// BlobReader in blob.go does not stream it (it always returns
+		// ErrBlobStreamingUnsupported).
+		if x.sqlsubtype == 1 { // TEXT
+			return reflect.TypeOf("")
+		}
 		return reflect.TypeOf([]byte{})
 	case SQL_TYPE_TIMESTAMP_TZ:
 		return reflect.TypeOf(time.Time{})
@@ -220,13 +223,37 @@ func (x *xSQLVAR) scantype() reflect.Type {
 }
 
 func (x *xSQLVAR) _parseTimezone(raw_value []byte) *time.Location {
-	fmt.Println("_parseTimezone")
-	fmt.Println(raw_value)
+	logger.Debugf("_parseTimezone: raw_value=%v", raw_value)
 	timezone := getTimezoneNameByID(int(bytes_to_bint16(raw_value)))
-	tz, _ := time.LoadLocation(timezone)
+	tz, err := time.LoadLocation(timezone)
+	if err != nil {
+		logger.Errorf("_parseTimezone: time.LoadLocation(%q): %s", timezone, err)
+		return time.UTC
+	}
 	return tz
 }
 
+func bytesToInt128(raw_value []byte) *big.Int {
+	hi := bytes_to_bint64(raw_value[:8])
+	lo := binary.BigEndian.Uint64(raw_value[8:])
+	i128 := big.NewInt(hi)
+	i128.Lsh(i128, 64)
+	i128.Add(i128, new(big.Int).SetUint64(lo))
+	return i128
+}
+
+// int128ToBytes is the inverse of bytesToInt128, used by the parameter encoder
+// to marshal NUMERIC(38, s) / INT128 bind values onto the wire.
+func int128ToBytes(i128 *big.Int) [16]byte {
+	var raw_value [16]byte
+	u := new(big.Int).And(i128, new(big.Int).SetUint64(^uint64(0)))
+	lo := u.Uint64()
+	hi := new(big.Int).Rsh(i128, 64).Int64()
+	binary.BigEndian.PutUint64(raw_value[:8], uint64(hi))
+	binary.BigEndian.PutUint64(raw_value[8:], lo)
+	return raw_value
+}
+
 func (x *xSQLVAR) _parseDate(raw_value []byte) (int, int, int) {
 	nday := int(bytes_to_bint32(raw_value)) + 678882
 	century := (4*nday - 1) / 146097
@@ -263,7 +290,11 @@ func (x *xSQLVAR) _parseTime(raw_value []byte) (int, int, int, int) {
 func (x *xSQLVAR) parseDate(raw_value []byte, timezone string) time.Time {
 	tz := time.Local
 	if timezone != "" {
-		tz, _ = time.LoadLocation(timezone)
+		if loc, err := time.LoadLocation(timezone); err != nil {
+			logger.Errorf("parseDate: time.LoadLocation(%q): %s", timezone, err)
+		} else {
+			tz = loc
+		}
 	}
 	year, month, day := x._parseDate(raw_value)
 	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, tz)
@@ -272,7 +303,11 @@ func (x *xSQLVAR) parseDate(raw_value []byte, timezone string) time.Time {
 func (x *xSQLVAR) parseTime(raw_value []byte, timezone string) time.Time {
 	tz := time.Local
 	if timezone != "" {
-		tz, _ = time.LoadLocation(timezone)
+		if loc, err := time.LoadLocation(timezone); err != nil {
+			logger.Errorf("parseTime: time.LoadLocation(%q): %s", timezone, err)
+		} else {
+			tz = loc
+		}
 	}
 	h, m, s, n := x._parseTime(raw_value)
 	return time.Date(0, time.Month(1), 1, h, m, s, n, tz)
@@ -281,7 +316,11 @@ func (x *xSQLVAR) parseTime(raw_value []byte, timezone string) time.Time {
 func (x *xSQLVAR) parseTimestamp(raw_value []byte, timezone string) time.Time {
 	tz := time.Local
 	if timezone != "" {
-		tz, _ = time.LoadLocation(timezone)
+		if loc, err := time.LoadLocation(timezone); err != nil {
+			logger.Errorf("parseTimestamp: time.LoadLocation(%q): %s", timezone, err)
+		} else {
+			tz = loc
+		}
 	}
 
 	year, month, day := x._parseDate(raw_value[:4])
@@ -302,126 +341,34 @@ func (x *xSQLVAR) parseTimestampTz(raw_value []byte) time.Time {
 	return time.Date(year, time.Month(month), day, h, m, s, n, tz)
 }
 
-func (x *xSQLVAR) parseString(raw_value []byte, charset string) interface{} {
+// decodeCharset decodes raw_value through the charset registry. It is kept
+// separate from parseString because the sqlsubtype flag it guards on is
+// overloaded across types: for CHAR/VARCHAR, sqlsubtype 1 means OCTETS
+// (binary passthrough), while for BLOB, sqlsubtype 1 means TEXT (decode).
+func decodeCharset(raw_value []byte, charset string) (interface{}, error) {
+	if charset == "OCTETS" {
+		return raw_value, nil
+	}
+	if dec, ok := LookupCharset(charset); ok {
+		v, err := dec(raw_value)
+		if err != nil {
+			logger.Errorf("decodeCharset: %s", err)
+			return nil, err
+		}
+		return v, nil
+	}
+	return bytes.NewBuffer(raw_value).String(), nil
+}
+
+func (x *xSQLVAR) parseString(raw_value []byte, charset string) (interface{}, error) {
 	if x.sqlsubtype == 1 { // OCTETS
-		return raw_value
+		return raw_value, nil
 	}
 	if x.sqlsubtype == 0 {
-		switch charset {
-		case "OCTETS":
-			return raw_value
-		case "UNICODE_FSS", "UTF8":
-			return bytes.NewBuffer(raw_value).String()
-		case "SJIS_0208":
-			dec := japanese.ShiftJIS.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "EUCJ_0208":
-			dec := japanese.EUCJP.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_1":
-			dec := charmap.ISO8859_1.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_2":
-			dec := charmap.ISO8859_2.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_3":
-			dec := charmap.ISO8859_3.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_4":
-			dec := charmap.ISO8859_5.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_5":
-			dec := charmap.ISO8859_5.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_6":
-			dec := charmap.ISO8859_6.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_7":
-			dec := charmap.ISO8859_7.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_8":
-			dec := charmap.ISO8859_8.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_9":
-			dec := charmap.ISO8859_9.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "ISO8859_13":
-			dec := charmap.ISO8859_13.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "KSC_5601":
-			dec := korean.EUCKR.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "WIN1250":
-			dec := charmap.Windows1250.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "WIN1251":
-			dec := charmap.Windows1251.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "WIN1252":
-			dec := charmap.Windows1252.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "WIN1253":
-			dec := charmap.Windows1252.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "WIN1254":
-			dec := charmap.Windows1252.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "BIG_5":
-			dec := traditionalchinese.Big5.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "GB_2312":
-			dec := simplifiedchinese.HZGB2312.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "WIN1255":
-			dec := charmap.Windows1255.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "WIN1256":
-			dec := charmap.Windows1256.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "WIN1257":
-			dec := charmap.Windows1257.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "KOI8R":
-			dec := charmap.KOI8R.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "KOI8U":
-			dec := charmap.KOI8U.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		case "WIN1258":
-			dec := charmap.Windows1258.NewDecoder()
-			v, _ := dec.Bytes(raw_value)
-			return string(v)
-		default:
-			return bytes.NewBuffer(raw_value).String()
-		}
+		return decodeCharset(raw_value, charset)
 	}
 
-	return raw_value
+	return raw_value, nil
 }
 
 func (x *xSQLVAR) value(raw_value []byte, timezone string, charset string) (v interface{}, err error) {
@@ -430,13 +377,13 @@ func (x *xSQLVAR) value(raw_value []byte, timezone string, charset string) (v in
 		if x.sqlsubtype == 1 { // OCTETS
 			v = raw_value
 		} else {
-			v = x.parseString(raw_value, charset)
+			v, err = x.parseString(raw_value, charset)
 		}
 	case SQL_TYPE_VARYING:
 		if x.sqlsubtype == 1 { // OCTETS
 			v = raw_value
 		} else {
-			v = x.parseString(raw_value, charset)
+			v, err = x.parseString(raw_value, charset)
 		}
 	case SQL_TYPE_SHORT:
 		i16 := int16(bytes_to_bint32(raw_value))
@@ -466,13 +413,12 @@ func (x *xSQLVAR) value(raw_value []byte, timezone string, charset string) (v in
 			v = i64
 		}
 	case SQL_TYPE_INT128:
-		i128 := big.NewInt(bytes_to_bint64(raw_value[:8]))
-		i128 = i128.Lsh(i128, 64)
-		low := big.NewInt(bytes_to_bint64(raw_value[8:]))
-		i128.Add(i128, low)
-		e := big.NewInt(int64(math.Pow10(x.sqlscale)))
-		i128.Mul(i128, e)
-		v = i128
+		i128 := bytesToInt128(raw_value)
+		if x.sqlscale != 0 {
+			v = decimal.NewFromBigInt(i128, int32(x.sqlscale))
+		} else {
+			v = i128
+		}
 	case SQL_TYPE_DATE:
 		v = x.parseDate(raw_value, timezone)
 	case SQL_TYPE_TIME:
@@ -480,8 +426,7 @@ func (x *xSQLVAR) value(raw_value []byte, timezone string, charset string) (v in
 	case SQL_TYPE_TIMESTAMP:
 		v = x.parseTimestamp(raw_value, timezone)
 	case SQL_TYPE_TIME_TZ:
-		fmt.Println("value()")
-		fmt.Println(raw_value)
+		logger.Debugf("value: SQL_TYPE_TIME_TZ raw_value=%v", raw_value)
 		v = x.parseTimeTz(raw_value)
 	case SQL_TYPE_TIMESTAMP_TZ:
 		v = x.parseTimestampTz(raw_value)
@@ -498,7 +443,11 @@ func (x *xSQLVAR) value(raw_value []byte, timezone string, charset string) (v in
 	case SQL_TYPE_BOOLEAN:
 		v = raw_value[0] != 0
 	case SQL_TYPE_BLOB:
-		v = raw_value
+		if x.sqlsubtype == 1 { // TEXT
+			v, err = decodeCharset(raw_value, charset)
+		} else {
+			v = raw_value
+		}
 	case SQL_TYPE_DEC_FIXED:
 		v = decimalFixedToDecimal(raw_value, int32(x.sqlscale))
 	case SQL_TYPE_DEC64: